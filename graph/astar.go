@@ -0,0 +1,94 @@
+package graph
+
+import "container/heap"
+
+// Heuristic estimates the remaining cost from node to goal. For A* to find
+// an optimal path the heuristic must be admissible: it must never
+// overestimate the true remaining cost.
+type Heuristic = func(node, goal string) uint
+
+// astarItem is an entry in the A* open set, ordered by g+h.
+type astarItem struct {
+	node string
+	g    uint
+	f    uint
+}
+
+type astarQueue []astarItem
+
+func (pq astarQueue) Len() int            { return len(pq) }
+func (pq astarQueue) Less(i, j int) bool   { return pq[i].f < pq[j].f }
+func (pq astarQueue) Swap(i, j int)        { pq[i], pq[j] = pq[j], pq[i] }
+func (pq *astarQueue) Push(x interface{}) { *pq = append(*pq, x.(astarItem)) }
+func (pq *astarQueue) Pop() interface{} {
+	old := *pq
+	n := len(old)
+	item := old[n-1]
+	*pq = old[:n-1]
+	return item
+}
+
+// ShortestPathAStar finds the cheapest path from "from" to "to" using A*
+// search guided by h. When h is nil, the search falls back to uniform-cost
+// (plain Dijkstra) ordering.
+func (g *baseGraph) ShortestPathAStar(from, to string, h Heuristic) ([]string, uint, error) {
+	if !g.hasNode(from) {
+		return nil, 0, ErrNodeNotFound
+	}
+
+	if h == nil {
+		h = func(string, string) uint { return 0 }
+	}
+
+	gScore := map[string]uint{from: 0}
+	prev := make(map[string]string)
+	visited := make(map[string]bool)
+
+	pq := &astarQueue{{node: from, g: 0, f: h(from, to)}}
+	heap.Init(pq)
+
+	for pq.Len() > 0 {
+		item := heap.Pop(pq).(astarItem)
+
+		if visited[item.node] {
+			continue
+		}
+		visited[item.node] = true
+
+		if item.node == to {
+			break
+		}
+
+		for _, e := range g.neighbors(item.node) {
+			next := item.g + e.Cost
+
+			if existing, ok := gScore[e.Neighbor]; !ok || next < existing {
+				gScore[e.Neighbor] = next
+				prev[e.Neighbor] = item.node
+				heap.Push(pq, astarItem{node: e.Neighbor, g: next, f: next + h(e.Neighbor, to)})
+			}
+		}
+	}
+
+	total, ok := gScore[to]
+	if !ok {
+		return nil, 0, ErrNoPath
+	}
+
+	path := []string{to}
+	for node := to; node != from; {
+		parent, ok := prev[node]
+		if !ok {
+			return nil, 0, ErrNoPath
+		}
+
+		path = append(path, parent)
+		node = parent
+	}
+
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+
+	return path, total, nil
+}
@@ -0,0 +1,73 @@
+package graph_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/karthick18/go-graph/graph"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShortestPathAStarUndirected(t *testing.T) {
+	g := graph.NewUndirectedGraph()
+
+	g.AddWithCostBoth(graph.Edge{Node: "a", Neighbor: "b", Cost: uint(3)})
+	g.AddWithCostBoth(graph.Edge{Node: "b", Neighbor: "c", Cost: uint(5)})
+	g.AddWithCostBoth(graph.Edge{Node: "a", Neighbor: "c", Cost: uint(8)})
+	g.AddWithCostBoth(graph.Edge{Node: "a", Neighbor: "d", Cost: uint(1)})
+	g.AddWithCostBoth(graph.Edge{Node: "d", Neighbor: "e", Cost: uint(10)})
+	g.AddWithCostBoth(graph.Edge{Node: "e", Neighbor: "c", Cost: uint(4)})
+	g.AddWithCostBoth(graph.Edge{Node: "c", Neighbor: "d", Cost: uint(6)})
+
+	dijkstraPath, dijkstraCost, err := g.ShortestPathAndCost("a", "e")
+	assert.Nil(t, err, "error finding shortest path via dijkstra")
+
+	// A zero heuristic is admissible for any graph, so A* must agree with
+	// plain Dijkstra exactly.
+	path, cost, err := g.ShortestPathAStar("a", "e", func(string, string) uint { return 0 })
+	assert.Nil(t, err, "error finding shortest path via A*")
+
+	assert.Equal(t, dijkstraCost, cost, "A* cost should match Dijkstra cost")
+	assert.Equal(t, strings.Join(dijkstraPath, "->"), strings.Join(path, "->"), "A* path should match Dijkstra path")
+}
+
+func TestShortestPathAStarNilHeuristicFallsBackToUniformCost(t *testing.T) {
+	g := graph.NewUndirectedGraph()
+
+	g.AddWithCostBoth(graph.Edge{Node: "a", Neighbor: "b", Cost: uint(3)})
+	g.AddWithCostBoth(graph.Edge{Node: "b", Neighbor: "c", Cost: uint(5)})
+	g.AddWithCostBoth(graph.Edge{Node: "a", Neighbor: "c", Cost: uint(8)})
+
+	path, cost, err := g.ShortestPathAStar("a", "c", nil)
+	assert.Nil(t, err, "error finding shortest path via A* with nil heuristic")
+	assert.Equal(t, "a->c", strings.Join(path, "->"), "nil heuristic should pick the direct edge")
+	assert.Equal(t, uint(8), cost, "nil heuristic should yield the uniform-cost shortest path")
+}
+
+func TestShortestPathAStarDirected(t *testing.T) {
+	dag := graph.NewDirectedGraph()
+
+	dag.AddWithCost(graph.Edge{Node: "5", Neighbor: "11", Cost: uint(3)})
+	dag.AddWithCost(graph.Edge{Node: "5", Neighbor: "7", Cost: uint(4)})
+	dag.AddWithCost(graph.Edge{Node: "11", Neighbor: "9", Cost: uint(7)})
+	dag.AddWithCost(graph.Edge{Node: "7", Neighbor: "11", Cost: uint(1)})
+	dag.AddWithCost(graph.Edge{Node: "7", Neighbor: "8", Cost: uint(2)})
+	dag.AddWithCost(graph.Edge{Node: "8", Neighbor: "9", Cost: uint(4)})
+
+	path, cost, err := dag.ShortestPathAStar("5", "9", func(string, string) uint { return 0 })
+	assert.Nil(t, err, "error finding shortest path via A* on DAG")
+	assert.Equal(t, "5->11->9", strings.Join(path, "->"), "A* path mismatch for DAG")
+	assert.Equal(t, uint(10), cost, "A* cost mismatch for DAG")
+}
+
+func TestShortestPathAStarErrors(t *testing.T) {
+	g := graph.NewUndirectedGraph()
+	g.AddWithCostBoth(graph.Edge{Node: "a", Neighbor: "b", Cost: uint(1)})
+	g.AddNode("isolated")
+
+	_, _, err := g.ShortestPathAStar("missing", "b", nil)
+	assert.ErrorIs(t, err, graph.ErrNodeNotFound, "unknown source should report ErrNodeNotFound")
+
+	_, _, err = g.ShortestPathAStar("a", "isolated", nil)
+	assert.ErrorIs(t, err, graph.ErrNoPath, "disconnected target should report ErrNoPath")
+}
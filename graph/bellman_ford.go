@@ -0,0 +1,103 @@
+package graph
+
+import "errors"
+
+// ErrNegativeCycle is returned by ShortestPathBellmanFord when the graph
+// contains a cycle reachable from the source whose total weight is
+// negative, making "shortest path" undefined.
+var ErrNegativeCycle = errors.New("graph: negative cycle reachable from source")
+
+// SPTree is a single-source shortest-path tree computed by
+// ShortestPathBellmanFord.
+type SPTree struct {
+	from string
+	dist map[string]int64
+	prev map[string]string
+}
+
+// Weight reports the shortest distance from the tree's source to to. The
+// second return value is false if to is unreachable.
+func (t *SPTree) Weight(to string) (int64, bool) {
+	d, ok := t.dist[to]
+	if !ok || d >= inf {
+		return 0, false
+	}
+
+	return d, true
+}
+
+// To reconstructs the shortest path from the tree's source to to, or nil if
+// to is unreachable.
+func (t *SPTree) To(to string) []string {
+	if d, ok := t.dist[to]; !ok || d >= inf {
+		return nil
+	}
+
+	path := []string{to}
+
+	for node := to; node != t.from; {
+		parent, ok := t.prev[node]
+		if !ok {
+			return nil
+		}
+
+		path = append(path, parent)
+		node = parent
+	}
+
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+
+	return path
+}
+
+// ShortestPathBellmanFord computes single-source shortest paths from from,
+// tolerating negative edge weights, via |V|-1 relaxation passes followed by
+// a |V|-th pass that detects a negative cycle reachable from from.
+func (g *baseGraph) ShortestPathBellmanFord(from string) (*SPTree, error) {
+	if !g.hasNode(from) {
+		return nil, ErrNodeNotFound
+	}
+
+	dist := make(map[string]int64, len(g.nodes))
+	for _, node := range g.nodes {
+		dist[node] = inf
+	}
+	dist[from] = 0
+
+	prev := make(map[string]string)
+	edges := g.signedEdges()
+
+	for i := 0; i < len(g.nodes)-1; i++ {
+		changed := false
+
+		for _, e := range edges {
+			if dist[e.Node] >= inf {
+				continue
+			}
+
+			if next := dist[e.Node] + e.Weight; next < dist[e.Neighbor] {
+				dist[e.Neighbor] = next
+				prev[e.Neighbor] = e.Node
+				changed = true
+			}
+		}
+
+		if !changed {
+			break
+		}
+	}
+
+	for _, e := range edges {
+		if dist[e.Node] >= inf {
+			continue
+		}
+
+		if dist[e.Node]+e.Weight < dist[e.Neighbor] {
+			return nil, ErrNegativeCycle
+		}
+	}
+
+	return &SPTree{from: from, dist: dist, prev: prev}, nil
+}
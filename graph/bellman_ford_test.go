@@ -0,0 +1,48 @@
+package graph_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/karthick18/go-graph/graph"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShortestPathBellmanFord(t *testing.T) {
+	g := graph.NewDirectedGraph()
+
+	g.AddSignedEdge(graph.SignedEdge{Node: "a", Neighbor: "b", Weight: int64(4)})
+	g.AddSignedEdge(graph.SignedEdge{Node: "a", Neighbor: "c", Weight: int64(2)})
+	g.AddSignedEdge(graph.SignedEdge{Node: "c", Neighbor: "b", Weight: int64(-1)})
+	g.AddNode("isolated")
+
+	tree, err := g.ShortestPathBellmanFord("a")
+	assert.Nil(t, err, "error computing bellman-ford tree")
+
+	weight, ok := tree.Weight("b")
+	assert.Equal(t, true, ok, "b should be reachable")
+	assert.Equal(t, int64(1), weight, "shortest a->b distance should route through the negative c->b edge")
+	assert.Equal(t, "a->c->b", strings.Join(tree.To("b"), "->"), "path reconstruction mismatch")
+
+	_, ok = tree.Weight("isolated")
+	assert.Equal(t, false, ok, "isolated node should be unreachable")
+	assert.Nil(t, tree.To("isolated"), "unreachable node should have no path")
+}
+
+func TestShortestPathBellmanFordNegativeCycle(t *testing.T) {
+	g := graph.NewDirectedGraph()
+
+	g.AddSignedEdge(graph.SignedEdge{Node: "a", Neighbor: "b", Weight: int64(1)})
+	g.AddSignedEdge(graph.SignedEdge{Node: "b", Neighbor: "a", Weight: int64(-3)})
+
+	_, err := g.ShortestPathBellmanFord("a")
+	assert.ErrorIs(t, err, graph.ErrNegativeCycle, "a negative cycle reachable from the source should be reported")
+}
+
+func TestShortestPathBellmanFordUnknownSource(t *testing.T) {
+	g := graph.NewDirectedGraph()
+	g.AddNode("a")
+
+	_, err := g.ShortestPathBellmanFord("missing")
+	assert.ErrorIs(t, err, graph.ErrNodeNotFound, "unknown source should report ErrNodeNotFound")
+}
@@ -0,0 +1,139 @@
+package graph
+
+import "container/heap"
+
+// pqItem is an entry in the Dijkstra priority queue.
+type pqItem struct {
+	node string
+	cost uint
+}
+
+// priorityQueue is a min-heap of pqItem ordered by cost.
+type priorityQueue []pqItem
+
+func (pq priorityQueue) Len() int            { return len(pq) }
+func (pq priorityQueue) Less(i, j int) bool   { return pq[i].cost < pq[j].cost }
+func (pq priorityQueue) Swap(i, j int)        { pq[i], pq[j] = pq[j], pq[i] }
+func (pq *priorityQueue) Push(x interface{}) { *pq = append(*pq, x.(pqItem)) }
+func (pq *priorityQueue) Pop() interface{} {
+	old := *pq
+	n := len(old)
+	item := old[n-1]
+	*pq = old[:n-1]
+	return item
+}
+
+// dijkstra runs single-source shortest paths from "from", returning the
+// cheapest cost to reach every visited node along with the predecessor used
+// to reach it.
+func (g *baseGraph) dijkstra(from string) (cost map[string]uint, prev map[string]string, err error) {
+	if !g.hasNode(from) {
+		return nil, nil, ErrNodeNotFound
+	}
+
+	cost = map[string]uint{from: 0}
+	prev = make(map[string]string)
+	visited := make(map[string]bool)
+
+	pq := &priorityQueue{{node: from, cost: 0}}
+	heap.Init(pq)
+
+	for pq.Len() > 0 {
+		item := heap.Pop(pq).(pqItem)
+
+		if visited[item.node] {
+			continue
+		}
+		visited[item.node] = true
+
+		for _, e := range g.neighbors(item.node) {
+			next := item.cost + e.Cost
+
+			if existing, ok := cost[e.Neighbor]; !ok || next < existing {
+				cost[e.Neighbor] = next
+				prev[e.Neighbor] = item.node
+				heap.Push(pq, pqItem{node: e.Neighbor, cost: next})
+			}
+		}
+	}
+
+	return cost, prev, nil
+}
+
+// ShortestPathAndCost returns the cheapest path from "from" to "to" and its
+// total cost, using Dijkstra's algorithm.
+func (g *baseGraph) ShortestPathAndCost(from, to string) ([]string, uint, error) {
+	cost, prev, err := g.dijkstra(from)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, ok := cost[to]
+	if !ok {
+		return nil, 0, ErrNoPath
+	}
+
+	path := []string{to}
+	for node := to; node != from; {
+		parent, ok := prev[node]
+		if !ok {
+			return nil, 0, ErrNoPath
+		}
+
+		path = append(path, parent)
+		node = parent
+	}
+
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+
+	return path, total, nil
+}
+
+// FindAllShortestPathsAndCost returns every simple path from "from" to "to"
+// that achieves the minimum cost, along with that cost.
+func (g *baseGraph) FindAllShortestPathsAndCost(from, to string) ([][]string, uint, error) {
+	cost, _, err := g.dijkstra(from)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	best, ok := cost[to]
+	if !ok {
+		return nil, 0, ErrNoPath
+	}
+
+	var paths [][]string
+	visited := map[string]bool{from: true}
+
+	var walk func(node string, running uint, path []string)
+	walk = func(node string, running uint, path []string) {
+		if running > best {
+			return
+		}
+
+		if node == to {
+			if running == best {
+				found := make([]string, len(path))
+				copy(found, path)
+				paths = append(paths, found)
+			}
+			return
+		}
+
+		for _, e := range g.neighbors(node) {
+			if visited[e.Neighbor] {
+				continue
+			}
+
+			visited[e.Neighbor] = true
+			walk(e.Neighbor, running+e.Cost, append(path, e.Neighbor))
+			visited[e.Neighbor] = false
+		}
+	}
+
+	walk(from, 0, []string{from})
+
+	return paths, best, nil
+}
@@ -0,0 +1,166 @@
+package graph
+
+import (
+	"fmt"
+	"sort"
+)
+
+// DirectedGraph is a weighted graph. Built exclusively through AddWithCost,
+// it enforces at insertion time that edges never introduce a cycle: it is a
+// DAG. That guarantee only holds for graphs built that way, though;
+// AddEdgeUnchecked deliberately bypasses it so that cyclic or self-looping
+// graphs — e.g. ones decoded from an untrusted digraph6 payload — can still
+// be constructed and inspected with StronglyConnectedComponents and
+// SimpleCycles.
+type DirectedGraph struct {
+	*baseGraph
+}
+
+// NewDirectedGraph creates an empty DirectedGraph.
+func NewDirectedGraph() *DirectedGraph {
+	return &DirectedGraph{baseGraph: newBaseGraph()}
+}
+
+// AddWithCost adds the directed edge e.Node->e.Neighbor. If e.Neighbor can
+// already reach e.Node, adding the edge would close a cycle, so it is
+// rejected with ErrLoopInDag and the graph is left unchanged.
+func (g *DirectedGraph) AddWithCost(e Edge) error {
+	if e.Node == e.Neighbor {
+		return fmt.Errorf("edge %s->%s is a self loop: %w", e.Node, e.Neighbor, ErrLoopInDag)
+	}
+
+	g.addNode(e.Node)
+	g.addNode(e.Neighbor)
+
+	if g.reaches(e.Neighbor, e.Node) {
+		return fmt.Errorf("edge %s->%s closes a cycle: %w", e.Node, e.Neighbor, ErrLoopInDag)
+	}
+
+	g.addEdge(e)
+
+	return nil
+}
+
+// AddEdgeUnchecked adds e.Node->e.Neighbor without enforcing the DAG
+// invariant that AddWithCost relies on. It exists so that graphs built from
+// an untrusted or externally-produced source — which may contain self loops
+// or cycles AddWithCost would reject outright — can still be constructed and
+// then inspected with StronglyConnectedComponents and SimpleCycles.
+func (g *DirectedGraph) AddEdgeUnchecked(e Edge) error {
+	g.addEdge(e)
+	return nil
+}
+
+// reaches reports whether to is reachable from via a simple BFS.
+func (g *DirectedGraph) reaches(from, to string) bool {
+	if !g.hasNode(from) {
+		return false
+	}
+
+	visited := map[string]bool{from: true}
+	queue := []string{from}
+
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+
+		if node == to {
+			return true
+		}
+
+		for _, e := range g.neighbors(node) {
+			if visited[e.Neighbor] {
+				continue
+			}
+
+			visited[e.Neighbor] = true
+			queue = append(queue, e.Neighbor)
+		}
+	}
+
+	return false
+}
+
+// Visit calls fn for every outgoing edge of node, in insertion order. fn may
+// return skip=true to stop visiting node's remaining neighbors early.
+func (g *DirectedGraph) Visit(node string, fn func(neighbor string, cost uint) (skip bool)) {
+	for _, e := range g.neighbors(node) {
+		if fn(e.Neighbor, e.Cost) {
+			return
+		}
+	}
+}
+
+// NodeAndDepth pairs a node with its depth in a traversal or topological
+// ordering.
+type NodeAndDepth struct {
+	Node  string
+	Depth int
+}
+
+// TopologicalSort returns the nodes of the DAG in topological order using
+// Kahn's algorithm, annotating each node with its depth: the length of the
+// longest path reaching it from any source node. Nodes sharing a depth are
+// ordered by how many outgoing edges they have, fewest first, so that
+// leaf-like nodes surface before nodes that branch further, falling back to
+// insertion order for any remaining ties.
+func (g *DirectedGraph) TopologicalSort() ([]NodeAndDepth, error) {
+	inDegree := make(map[string]int, len(g.nodes))
+	for _, node := range g.nodes {
+		inDegree[node] = 0
+	}
+
+	for _, node := range g.nodes {
+		for _, e := range g.neighbors(node) {
+			inDegree[e.Neighbor]++
+		}
+	}
+
+	depth := make(map[string]int, len(g.nodes))
+	queue := make([]string, 0, len(g.nodes))
+
+	for _, node := range g.nodes {
+		if inDegree[node] == 0 {
+			queue = append(queue, node)
+		}
+	}
+
+	processed := 0
+
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		processed++
+
+		for _, e := range g.neighbors(node) {
+			inDegree[e.Neighbor]--
+
+			if depth[node]+1 > depth[e.Neighbor] {
+				depth[e.Neighbor] = depth[node] + 1
+			}
+
+			if inDegree[e.Neighbor] == 0 {
+				queue = append(queue, e.Neighbor)
+			}
+		}
+	}
+
+	if processed != len(g.nodes) {
+		return nil, fmt.Errorf("topological sort: %w", ErrLoopInDag)
+	}
+
+	result := make([]NodeAndDepth, len(g.nodes))
+	for i, node := range g.nodes {
+		result[i] = NodeAndDepth{Node: node, Depth: depth[node]}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].Depth != result[j].Depth {
+			return result[i].Depth < result[j].Depth
+		}
+
+		return len(g.neighbors(result[i].Node)) < len(g.neighbors(result[j].Node))
+	})
+
+	return result, nil
+}
@@ -0,0 +1,89 @@
+// Package digraph6 encodes and decodes DirectedGraph values using the
+// digraph6 interchange format, graph6's directed sibling.
+package digraph6
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/karthick18/go-graph/graph"
+	"github.com/karthick18/go-graph/graph/encoding/internal/sixbit"
+)
+
+// ErrMissingMarker is returned by Decode when the input does not start with
+// the '&' marker that distinguishes digraph6 from graph6.
+var ErrMissingMarker = errors.New("digraph6: input is missing leading '&' marker")
+
+// Encode renders g as a digraph6 string: a leading '&' followed by N(n) and
+// the full n*n adjacency matrix, row-major. Vertex order follows g's own
+// node insertion order.
+func Encode(g *graph.DirectedGraph) (string, error) {
+	nodes := g.Nodes()
+	n := len(nodes)
+
+	header, err := sixbit.EncodeN(n)
+	if err != nil {
+		return "", err
+	}
+
+	bits := make([]bool, 0, n*n)
+
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			bits = append(bits, g.HasEdge(nodes[i], nodes[j]))
+		}
+	}
+
+	var b strings.Builder
+	b.WriteByte('&')
+	b.Write(header)
+	b.Write(sixbit.PackBits(bits))
+
+	return b.String(), nil
+}
+
+// Decode parses a digraph6 string into a DirectedGraph. Vertices are named
+// "0" through "n-1" in adjacency-matrix order. digraph6 payloads are under
+// no obligation to be acyclic, so edges are added via AddEdgeUnchecked
+// rather than AddWithCost: self loops and cycles in the encoded matrix
+// round-trip instead of failing with graph.ErrLoopInDag.
+func Decode(s string) (*graph.DirectedGraph, error) {
+	if !strings.HasPrefix(s, "&") {
+		return nil, ErrMissingMarker
+	}
+
+	n, rest, err := sixbit.DecodeN([]byte(s[1:]))
+	if err != nil {
+		return nil, err
+	}
+
+	bits, err := sixbit.UnpackBits(rest, n*n)
+	if err != nil {
+		return nil, err
+	}
+
+	g := graph.NewDirectedGraph()
+	names := make([]string, n)
+
+	for i := 0; i < n; i++ {
+		names[i] = strconv.Itoa(i)
+		g.AddNode(names[i])
+	}
+
+	k := 0
+
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if bits[k] {
+				if err := g.AddEdgeUnchecked(graph.Edge{Node: names[i], Neighbor: names[j], Cost: 1}); err != nil {
+					return nil, err
+				}
+			}
+
+			k++
+		}
+	}
+
+	return g, nil
+}
@@ -0,0 +1,54 @@
+package digraph6_test
+
+import (
+	"testing"
+
+	"github.com/karthick18/go-graph/graph"
+	"github.com/karthick18/go-graph/graph/encoding/digraph6"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeDecodeRoundTrip(t *testing.T) {
+	g := graph.NewDirectedGraph()
+	g.AddWithCost(graph.Edge{Node: "0", Neighbor: "1", Cost: uint(1)})
+	g.AddWithCost(graph.Edge{Node: "1", Neighbor: "2", Cost: uint(1)})
+
+	encoded, err := digraph6.Encode(g)
+	assert.Nil(t, err, "error encoding digraph6")
+
+	decoded, err := digraph6.Decode(encoded)
+	assert.Nil(t, err, "error decoding digraph6")
+
+	assert.Equal(t, g.Order(), decoded.Order(), "decoded order mismatch")
+
+	for _, u := range decoded.Nodes() {
+		for _, v := range decoded.Nodes() {
+			assert.Equal(t, g.HasEdge(u, v), decoded.HasEdge(u, v), "decoded adjacency mismatch for "+u+"->"+v)
+		}
+	}
+}
+
+func TestDecodeSelfLoopAndCycle(t *testing.T) {
+	// A self loop and a cycle-closing edge are both invalid input to
+	// AddWithCost, but are perfectly valid digraph6 payloads, so Decode must
+	// round-trip them via AddEdgeUnchecked rather than failing.
+	g := graph.NewDirectedGraph()
+	assert.Nil(t, g.AddEdgeUnchecked(graph.Edge{Node: "0", Neighbor: "0", Cost: uint(1)}), "unchecked self loop failed")
+	assert.Nil(t, g.AddEdgeUnchecked(graph.Edge{Node: "0", Neighbor: "1", Cost: uint(1)}), "unchecked add 0->1 failed")
+	assert.Nil(t, g.AddEdgeUnchecked(graph.Edge{Node: "1", Neighbor: "0", Cost: uint(1)}), "unchecked add 1->0 failed")
+
+	encoded, err := digraph6.Encode(g)
+	assert.Nil(t, err, "error encoding digraph6 with self loop and cycle")
+
+	decoded, err := digraph6.Decode(encoded)
+	assert.Nil(t, err, "Decode should support self loops and cycles, not just acyclic input")
+
+	assert.Equal(t, true, decoded.HasEdge("0", "0"), "self loop should round-trip")
+	assert.Equal(t, true, decoded.HasEdge("0", "1"), "0->1 should round-trip")
+	assert.Equal(t, true, decoded.HasEdge("1", "0"), "cycle-closing edge 1->0 should round-trip")
+}
+
+func TestDecodeMissingMarker(t *testing.T) {
+	_, err := digraph6.Decode("A_")
+	assert.ErrorIs(t, err, digraph6.ErrMissingMarker, "input without leading & should be rejected")
+}
@@ -0,0 +1,71 @@
+// Package graph6 encodes and decodes UndirectedGraph values using the
+// graph6 interchange format, a compact printable-ASCII representation used
+// across the wider graph tooling ecosystem.
+package graph6
+
+import (
+	"strconv"
+
+	"github.com/karthick18/go-graph/graph"
+	"github.com/karthick18/go-graph/graph/encoding/internal/sixbit"
+)
+
+// Encode renders g as a graph6 string. Vertex order follows g's own node
+// insertion order.
+func Encode(g *graph.UndirectedGraph) (string, error) {
+	nodes := g.Nodes()
+	n := len(nodes)
+
+	header, err := sixbit.EncodeN(n)
+	if err != nil {
+		return "", err
+	}
+
+	bits := make([]bool, 0, n*(n-1)/2)
+
+	for j := 1; j < n; j++ {
+		for i := 0; i < j; i++ {
+			bits = append(bits, g.HasEdge(nodes[i], nodes[j]) || g.HasEdge(nodes[j], nodes[i]))
+		}
+	}
+
+	return string(header) + string(sixbit.PackBits(bits)), nil
+}
+
+// Decode parses a graph6 string into an UndirectedGraph. Vertices are named
+// "0" through "n-1" in the order they appear in the encoded matrix.
+func Decode(s string) (*graph.UndirectedGraph, error) {
+	n, rest, err := sixbit.DecodeN([]byte(s))
+	if err != nil {
+		return nil, err
+	}
+
+	bits, err := sixbit.UnpackBits(rest, n*(n-1)/2)
+	if err != nil {
+		return nil, err
+	}
+
+	g := graph.NewUndirectedGraph()
+	names := make([]string, n)
+
+	for i := 0; i < n; i++ {
+		names[i] = strconv.Itoa(i)
+		g.AddNode(names[i])
+	}
+
+	k := 0
+
+	for j := 1; j < n; j++ {
+		for i := 0; i < j; i++ {
+			if bits[k] {
+				if err := g.AddWithCostBoth(graph.Edge{Node: names[i], Neighbor: names[j], Cost: 1}); err != nil {
+					return nil, err
+				}
+			}
+
+			k++
+		}
+	}
+
+	return g, nil
+}
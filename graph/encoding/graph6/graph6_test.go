@@ -0,0 +1,40 @@
+package graph6_test
+
+import (
+	"testing"
+
+	"github.com/karthick18/go-graph/graph"
+	"github.com/karthick18/go-graph/graph/encoding/graph6"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeDecodeRoundTrip(t *testing.T) {
+	g := graph.NewUndirectedGraph()
+	g.AddWithCostBoth(graph.Edge{Node: "0", Neighbor: "1", Cost: uint(1)})
+	g.AddWithCostBoth(graph.Edge{Node: "1", Neighbor: "2", Cost: uint(1)})
+
+	encoded, err := graph6.Encode(g)
+	assert.Nil(t, err, "error encoding graph6")
+
+	decoded, err := graph6.Decode(encoded)
+	assert.Nil(t, err, "error decoding graph6")
+
+	assert.Equal(t, g.Order(), decoded.Order(), "decoded order mismatch")
+
+	for _, u := range decoded.Nodes() {
+		for _, v := range decoded.Nodes() {
+			assert.Equal(t, g.HasEdge(u, v), decoded.HasEdge(u, v), "decoded adjacency mismatch for "+u+"->"+v)
+		}
+	}
+}
+
+func TestDecodeKnownPayload(t *testing.T) {
+	// "A_" is the canonical graph6 encoding of a single edge between two
+	// vertices (N(2) followed by the one-bit upper triangle set to 1).
+	g, err := graph6.Decode("A_")
+	assert.Nil(t, err, "error decoding known graph6 payload")
+
+	assert.Equal(t, 2, g.Order(), "decoded order mismatch")
+	assert.Equal(t, true, g.HasEdge("0", "1"), "expected edge 0-1")
+	assert.Equal(t, true, g.HasEdge("1", "0"), "expected reverse edge 1-0")
+}
@@ -0,0 +1,118 @@
+// Package sixbit implements the small-nonnegative-integer and bit-packing
+// primitives shared by the graph6 and digraph6 codecs.
+package sixbit
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrShortInput is returned when a buffer ends before a complete field has
+// been read.
+var ErrShortInput = errors.New("sixbit: input ends before expected field")
+
+// maxN is the largest vertex count the 36-bit extended form can represent.
+const maxN = 1<<36 - 1
+
+// EncodeN encodes n using graph6's small-nonnegative-integer scheme: a
+// single byte n+63 for n<63, a 4-byte form (marker 126 plus 3 six-bit
+// digits) for n<2^18, or an 8-byte form (two marker bytes plus 6 six-bit
+// digits) for n<2^36.
+func EncodeN(n int) ([]byte, error) {
+	if n < 0 || n > maxN {
+		return nil, fmt.Errorf("sixbit: %d out of range", n)
+	}
+
+	if n <= 62 {
+		return []byte{byte(n + 63)}, nil
+	}
+
+	if n <= 1<<18-1 {
+		return []byte{
+			126,
+			byte((n>>12)&0x3F) + 63,
+			byte((n>>6)&0x3F) + 63,
+			byte(n&0x3F) + 63,
+		}, nil
+	}
+
+	out := []byte{126, 126}
+	for shift := 30; shift >= 0; shift -= 6 {
+		out = append(out, byte((n>>uint(shift))&0x3F)+63)
+	}
+
+	return out, nil
+}
+
+// DecodeN decodes the leading N(n) field from data and returns n along with
+// the remaining, unconsumed bytes.
+func DecodeN(data []byte) (n int, rest []byte, err error) {
+	if len(data) == 0 {
+		return 0, nil, ErrShortInput
+	}
+
+	if data[0] != 126 {
+		return int(data[0]) - 63, data[1:], nil
+	}
+
+	if len(data) < 4 {
+		return 0, nil, ErrShortInput
+	}
+
+	if data[1] != 126 {
+		n = int(data[1]-63)<<12 | int(data[2]-63)<<6 | int(data[3]-63)
+		return n, data[4:], nil
+	}
+
+	if len(data) < 8 {
+		return 0, nil, ErrShortInput
+	}
+
+	for _, b := range data[2:8] {
+		n = n<<6 | int(b-63)
+	}
+
+	return n, data[8:], nil
+}
+
+// PackBits packs bits six at a time, most-significant-bit first, zero
+// padding the final group, and offsets each resulting 6-bit value by 63 to
+// produce printable ASCII bytes.
+func PackBits(bits []bool) []byte {
+	out := make([]byte, 0, (len(bits)+5)/6)
+
+	for i := 0; i < len(bits); i += 6 {
+		var v byte
+
+		for b := 0; b < 6; b++ {
+			v <<= 1
+
+			if i+b < len(bits) && bits[i+b] {
+				v |= 1
+			}
+		}
+
+		out = append(out, v+63)
+	}
+
+	return out
+}
+
+// UnpackBits reverses PackBits, returning exactly count bits.
+func UnpackBits(data []byte, count int) ([]bool, error) {
+	bits := make([]bool, 0, len(data)*6)
+
+	for _, by := range data {
+		v := by - 63
+
+		for b := 5; b >= 0; b-- {
+			bits = append(bits, (v>>uint(b))&1 == 1)
+		}
+	}
+
+	if len(bits) < count {
+		return nil, ErrShortInput
+	}
+
+	return bits[:count], nil
+}
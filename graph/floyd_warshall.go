@@ -0,0 +1,148 @@
+package graph
+
+import "math"
+
+// inf is used as the "unreachable" sentinel in the Floyd-Warshall distance
+// matrix. It is kept well clear of math.MaxInt64 so that relaxations never
+// overflow when adding two "unreachable" distances together.
+const inf = int64(math.MaxInt64 / 4)
+
+// AllPairs holds the dense all-pairs shortest distances and next-hop
+// routing table computed by FloydWarshall.
+type AllPairs struct {
+	nodes []string
+	index map[string]int
+	dist  [][]int64
+	next  [][]int
+}
+
+// Weight reports the shortest distance from u to v, which may be negative
+// when the graph carries signed edges. The second return value is false if
+// either node is unknown or v is unreachable from u.
+func (a *AllPairs) Weight(u, v string) (int64, bool) {
+	i, ok := a.index[u]
+	if !ok {
+		return 0, false
+	}
+
+	j, ok := a.index[v]
+	if !ok {
+		return 0, false
+	}
+
+	if a.dist[i][j] >= inf {
+		return 0, false
+	}
+
+	return a.dist[i][j], true
+}
+
+// Path reconstructs the shortest path from u to v using the next-hop table,
+// or nil if no path exists. If FloydWarshall reported a negative cycle, the
+// route from u to v may pass through it, in which case next-hops loop
+// forever instead of reaching v; Path guards against this by giving up and
+// returning nil once it has taken as many hops as there are nodes in the
+// graph, rather than reconstructing a meaningless or endless path.
+func (a *AllPairs) Path(u, v string) []string {
+	i, ok := a.index[u]
+	if !ok {
+		return nil
+	}
+
+	j, ok := a.index[v]
+	if !ok {
+		return nil
+	}
+
+	if i == j {
+		return []string{u}
+	}
+
+	if a.next[i][j] == -1 {
+		return nil
+	}
+
+	path := []string{u}
+	cur := i
+
+	for hop := 0; cur != j; hop++ {
+		if hop >= len(a.nodes) {
+			return nil
+		}
+
+		cur = a.next[cur][j]
+		path = append(path, a.nodes[cur])
+	}
+
+	return path
+}
+
+// FloydWarshall computes all-pairs shortest distances with the classic
+// O(V^3) triple loop. The returned bool is false if the graph contains a
+// negative cycle (a node whose shortest distance to itself is negative),
+// in which case the distances touched by that cycle are not meaningful.
+func (g *baseGraph) FloydWarshall() (*AllPairs, bool) {
+	n := len(g.nodes)
+	index := make(map[string]int, n)
+
+	for i, node := range g.nodes {
+		index[node] = i
+	}
+
+	dist := make([][]int64, n)
+	next := make([][]int, n)
+
+	for i := range dist {
+		dist[i] = make([]int64, n)
+		next[i] = make([]int, n)
+
+		for j := range dist[i] {
+			next[i][j] = -1
+
+			if i == j {
+				dist[i][j] = 0
+			} else {
+				dist[i][j] = inf
+			}
+		}
+	}
+
+	for _, e := range g.signedEdges() {
+		u, v := index[e.Node], index[e.Neighbor]
+
+		if e.Weight < dist[u][v] {
+			dist[u][v] = e.Weight
+			next[u][v] = v
+		}
+	}
+
+	for k := 0; k < n; k++ {
+		for i := 0; i < n; i++ {
+			if dist[i][k] >= inf {
+				continue
+			}
+
+			for j := 0; j < n; j++ {
+				if dist[k][j] >= inf {
+					continue
+				}
+
+				if via := dist[i][k] + dist[k][j]; via < dist[i][j] {
+					dist[i][j] = via
+					next[i][j] = next[i][k]
+				}
+			}
+		}
+	}
+
+	ok := true
+
+	for i := 0; i < n; i++ {
+		if dist[i][i] < 0 {
+			ok = false
+			break
+		}
+	}
+
+	return &AllPairs{nodes: g.nodes, index: index, dist: dist, next: next}, ok
+}
@@ -0,0 +1,126 @@
+// Package graph implements simple weighted undirected and directed graphs
+// with shortest path, traversal and topological sort support.
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrNodeNotFound is returned when an operation references a node that has
+// never been added to the graph.
+var ErrNodeNotFound = errors.New("graph: node not found")
+
+// ErrNoPath is returned when no path exists between two nodes.
+var ErrNoPath = errors.New("graph: no path between nodes")
+
+// ErrLoopInDag is returned by DirectedGraph.AddWithCost when adding an edge
+// would introduce a cycle into what is expected to remain a DAG.
+var ErrLoopInDag = errors.New("graph: edge introduces a loop in dag")
+
+// Edge describes a weighted connection from Node to Neighbor.
+type Edge struct {
+	Node     string
+	Neighbor string
+	Cost     uint
+}
+
+// baseGraph holds the adjacency representation shared by UndirectedGraph and
+// DirectedGraph. Nodes are kept in insertion order so that traversals and
+// reports are deterministic.
+type baseGraph struct {
+	nodes     []string
+	index     map[string]int
+	adj       map[string][]Edge
+	size      int
+	signedAdj map[string][]SignedEdge
+}
+
+func newBaseGraph() *baseGraph {
+	return &baseGraph{
+		index:     make(map[string]int),
+		adj:       make(map[string][]Edge),
+		signedAdj: make(map[string][]SignedEdge),
+	}
+}
+
+func (g *baseGraph) addNode(node string) {
+	if _, ok := g.index[node]; ok {
+		return
+	}
+
+	g.index[node] = len(g.nodes)
+	g.nodes = append(g.nodes, node)
+}
+
+func (g *baseGraph) addEdge(e Edge) {
+	g.addNode(e.Node)
+	g.addNode(e.Neighbor)
+	g.adj[e.Node] = append(g.adj[e.Node], e)
+	g.size++
+}
+
+func (g *baseGraph) hasNode(node string) bool {
+	_, ok := g.index[node]
+	return ok
+}
+
+func (g *baseGraph) neighbors(node string) []Edge {
+	return g.adj[node]
+}
+
+// AddNode registers node with no edges if it is not already part of the
+// graph. It is a no-op if node already exists, so it is safe to call before
+// adding edges to ensure isolated nodes show up in Order() and Nodes().
+func (g *baseGraph) AddNode(node string) {
+	g.addNode(node)
+}
+
+// Nodes returns every node in the graph, in insertion order.
+func (g *baseGraph) Nodes() []string {
+	nodes := make([]string, len(g.nodes))
+	copy(nodes, g.nodes)
+	return nodes
+}
+
+// HasEdge reports whether there is a direct edge from "from" to "to".
+func (g *baseGraph) HasEdge(from, to string) bool {
+	for _, e := range g.adj[from] {
+		if e.Neighbor == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Order returns the number of nodes in the graph.
+func (g *baseGraph) Order() int {
+	return len(g.nodes)
+}
+
+// Size returns the number of edges in the graph. Undirected edges are
+// counted once per direction, matching the adjacency entries actually
+// stored.
+func (g *baseGraph) Size() int {
+	return g.size
+}
+
+// String renders the graph as its adjacency list, primarily for debugging
+// and test logging.
+func (g *baseGraph) String() string {
+	var b strings.Builder
+
+	for _, node := range g.nodes {
+		fmt.Fprintf(&b, "%s:", node)
+
+		for _, e := range g.adj[node] {
+			fmt.Fprintf(&b, " %s(%d)", e.Neighbor, e.Cost)
+		}
+
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
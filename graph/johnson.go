@@ -0,0 +1,110 @@
+package graph
+
+// SimpleCycles enumerates every elementary circuit in the graph using
+// Johnson's algorithm, run once per non-trivial strongly connected
+// component. Where StronglyConnectedComponents only tells you that a group
+// of nodes is mutually reachable, this reports the actual cycles.
+func (g *DirectedGraph) SimpleCycles() [][]string {
+	var cycles [][]string
+
+	for _, scc := range g.StronglyConnectedComponents() {
+		if len(scc) < 2 {
+			// A singleton component is only a cycle if its one node has a
+			// self loop; StronglyConnectedComponents can't tell the two
+			// apart, since both look like a single mutually-reachable node.
+			if len(scc) == 1 && g.HasEdge(scc[0], scc[0]) {
+				cycles = append(cycles, []string{scc[0]})
+			}
+
+			continue
+		}
+
+		cycles = append(cycles, johnsonCircuits(g, scc)...)
+	}
+
+	return cycles
+}
+
+// johnsonCircuits finds every elementary circuit within the subgraph induced
+// by component, a single strongly connected component.
+func johnsonCircuits(g *DirectedGraph, component []string) [][]string {
+	inComponent := make(map[string]bool, len(component))
+	for _, node := range component {
+		inComponent[node] = true
+	}
+
+	var circuits [][]string
+
+	for start := 0; start < len(component); start++ {
+		s := component[start]
+		eligible := make(map[string]bool, len(component)-start)
+		for _, node := range component[start:] {
+			eligible[node] = true
+		}
+
+		blocked := make(map[string]bool)
+		blockedBy := make(map[string]map[string]bool)
+		var stack []string
+
+		var unblock func(node string)
+		unblock = func(node string) {
+			blocked[node] = false
+
+			for b := range blockedBy[node] {
+				delete(blockedBy[node], b)
+
+				if blocked[b] {
+					unblock(b)
+				}
+			}
+		}
+
+		var walk func(node string) bool
+		walk = func(node string) bool {
+			foundCircuit := false
+			stack = append(stack, node)
+			blocked[node] = true
+
+			for _, e := range g.neighbors(node) {
+				if !inComponent[e.Neighbor] || !eligible[e.Neighbor] {
+					continue
+				}
+
+				if e.Neighbor == s {
+					circuit := make([]string, len(stack))
+					copy(circuit, stack)
+					circuits = append(circuits, circuit)
+					foundCircuit = true
+				} else if !blocked[e.Neighbor] {
+					if walk(e.Neighbor) {
+						foundCircuit = true
+					}
+				}
+			}
+
+			if foundCircuit {
+				unblock(node)
+			} else {
+				for _, e := range g.neighbors(node) {
+					if !inComponent[e.Neighbor] || !eligible[e.Neighbor] {
+						continue
+					}
+
+					if blockedBy[e.Neighbor] == nil {
+						blockedBy[e.Neighbor] = make(map[string]bool)
+					}
+
+					blockedBy[e.Neighbor][node] = true
+				}
+			}
+
+			stack = stack[:len(stack)-1]
+
+			return foundCircuit
+		}
+
+		walk(s)
+	}
+
+	return circuits
+}
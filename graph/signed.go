@@ -0,0 +1,49 @@
+package graph
+
+// SignedEdge is a weighted connection whose cost may be negative. It backs
+// algorithms such as Bellman-Ford and the negative-cycle-aware
+// Floyd-Warshall, which need to represent costs as gains or losses rather
+// than the graph's ordinary non-negative Cost.
+type SignedEdge struct {
+	Node     string
+	Neighbor string
+	Weight   int64
+}
+
+// AddSignedEdge registers a directed signed edge without affecting the
+// graph's ordinary (non-negative) adjacency used by Dijkstra and friends.
+func (g *baseGraph) AddSignedEdge(e SignedEdge) error {
+	g.addNode(e.Node)
+	g.addNode(e.Neighbor)
+	g.signedAdj[e.Node] = append(g.signedAdj[e.Node], e)
+
+	return nil
+}
+
+// AddSignedEdgeBoth registers e and its reverse with the same weight.
+func (g *UndirectedGraph) AddSignedEdgeBoth(e SignedEdge) error {
+	if err := g.AddSignedEdge(e); err != nil {
+		return err
+	}
+
+	return g.AddSignedEdge(SignedEdge{Node: e.Neighbor, Neighbor: e.Node, Weight: e.Weight})
+}
+
+// signedEdges returns every edge in the graph as a SignedEdge: explicitly
+// added signed edges plus the ordinary Cost-based edges promoted to a
+// non-negative signed weight.
+func (g *baseGraph) signedEdges() []SignedEdge {
+	edges := make([]SignedEdge, 0, g.size+len(g.signedAdj))
+
+	for _, node := range g.nodes {
+		for _, e := range g.adj[node] {
+			edges = append(edges, SignedEdge{Node: e.Node, Neighbor: e.Neighbor, Weight: int64(e.Cost)})
+		}
+
+		for _, e := range g.signedAdj[node] {
+			edges = append(edges, e)
+		}
+	}
+
+	return edges
+}
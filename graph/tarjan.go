@@ -0,0 +1,87 @@
+package graph
+
+// StronglyConnectedComponents finds the strongly connected components of the
+// graph using Tarjan's algorithm, implemented iteratively with an explicit
+// work stack so that large graphs cannot blow the goroutine stack the way a
+// naive recursive implementation would. Components are returned in reverse
+// topological order, as Tarjan's algorithm produces them.
+func (g *DirectedGraph) StronglyConnectedComponents() [][]string {
+	index := make(map[string]int, len(g.nodes))
+	low := make(map[string]int, len(g.nodes))
+	onStack := make(map[string]bool, len(g.nodes))
+	var nodeStack []string
+	var components [][]string
+	counter := 0
+
+	// frame tracks one node's position on the explicit work stack: which
+	// neighbor to visit next, so the DFS can be resumed after descending
+	// into a child instead of recursing.
+	type frame struct {
+		node string
+		next int
+	}
+
+	for _, start := range g.nodes {
+		if _, seen := index[start]; seen {
+			continue
+		}
+
+		work := []*frame{{node: start}}
+		index[start] = counter
+		low[start] = counter
+		counter++
+		nodeStack = append(nodeStack, start)
+		onStack[start] = true
+
+		for len(work) > 0 {
+			top := work[len(work)-1]
+			neighbors := g.neighbors(top.node)
+
+			if top.next < len(neighbors) {
+				e := neighbors[top.next]
+				top.next++
+
+				if _, seen := index[e.Neighbor]; !seen {
+					index[e.Neighbor] = counter
+					low[e.Neighbor] = counter
+					counter++
+					nodeStack = append(nodeStack, e.Neighbor)
+					onStack[e.Neighbor] = true
+					work = append(work, &frame{node: e.Neighbor})
+				} else if onStack[e.Neighbor] && index[e.Neighbor] < low[top.node] {
+					low[top.node] = index[e.Neighbor]
+				}
+
+				continue
+			}
+
+			work = work[:len(work)-1]
+
+			if len(work) > 0 {
+				parent := work[len(work)-1]
+				if low[top.node] < low[parent.node] {
+					low[parent.node] = low[top.node]
+				}
+			}
+
+			if low[top.node] == index[top.node] {
+				var component []string
+
+				for {
+					n := nodeStack[len(nodeStack)-1]
+					nodeStack = nodeStack[:len(nodeStack)-1]
+					onStack[n] = false
+					component = append(component, n)
+
+					if n == top.node {
+						break
+					}
+				}
+
+				components = append(components, component)
+			}
+		}
+	}
+
+	return components
+}
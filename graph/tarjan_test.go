@@ -0,0 +1,64 @@
+package graph_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/karthick18/go-graph/graph"
+	"github.com/stretchr/testify/assert"
+)
+
+// cyclic builds a->b->c->a plus an acyclic b->d tail. AddWithCost would
+// reject the c->a edge outright since it closes a cycle, so the cycle is
+// built with AddEdgeUnchecked instead.
+func cyclic(t *testing.T) *graph.DirectedGraph {
+	t.Helper()
+
+	g := graph.NewDirectedGraph()
+
+	assert.Nil(t, g.AddEdgeUnchecked(graph.Edge{Node: "a", Neighbor: "b", Cost: uint(1)}), "unchecked add a->b failed")
+	assert.Nil(t, g.AddEdgeUnchecked(graph.Edge{Node: "b", Neighbor: "c", Cost: uint(1)}), "unchecked add b->c failed")
+	assert.Nil(t, g.AddEdgeUnchecked(graph.Edge{Node: "c", Neighbor: "a", Cost: uint(1)}), "unchecked add c->a failed")
+	assert.Nil(t, g.AddEdgeUnchecked(graph.Edge{Node: "b", Neighbor: "d", Cost: uint(1)}), "unchecked add b->d failed")
+
+	return g
+}
+
+func TestStronglyConnectedComponents(t *testing.T) {
+	g := cyclic(t)
+
+	sccs := g.StronglyConnectedComponents()
+	expected := [][]string{{"d"}, {"c", "b", "a"}}
+
+	assert.Equal(t, true, reflect.DeepEqual(sccs, expected), "SCC mismatch")
+}
+
+func TestSimpleCycles(t *testing.T) {
+	g := cyclic(t)
+
+	cycles := g.SimpleCycles()
+	expected := [][]string{{"c", "a", "b"}}
+
+	assert.Equal(t, true, reflect.DeepEqual(cycles, expected), "simple cycles mismatch")
+}
+
+func TestSimpleCyclesSelfLoop(t *testing.T) {
+	g := graph.NewDirectedGraph()
+
+	assert.Nil(t, g.AddEdgeUnchecked(graph.Edge{Node: "a", Neighbor: "a", Cost: uint(1)}), "unchecked self loop failed")
+	assert.Nil(t, g.AddEdgeUnchecked(graph.Edge{Node: "b", Neighbor: "c", Cost: uint(1)}), "unchecked add b->c failed")
+	assert.Nil(t, g.AddEdgeUnchecked(graph.Edge{Node: "c", Neighbor: "b", Cost: uint(1)}), "unchecked add c->b failed")
+
+	cycles := g.SimpleCycles()
+	expected := [][]string{{"a"}, {"c", "b"}}
+
+	assert.Equal(t, true, reflect.DeepEqual(cycles, expected), "self loop should surface as its own one-node cycle alongside the b<->c cycle")
+}
+
+func TestSimpleCyclesNoCycle(t *testing.T) {
+	dag := graph.NewDirectedGraph()
+	dag.AddWithCost(graph.Edge{Node: "a", Neighbor: "b", Cost: uint(1)})
+	dag.AddWithCost(graph.Edge{Node: "b", Neighbor: "c", Cost: uint(1)})
+
+	assert.Equal(t, 0, len(dag.SimpleCycles()), "a DAG has no elementary circuits")
+}
@@ -0,0 +1,64 @@
+package graph
+
+// BFS walks the graph breadth-first starting at "from", calling visit for
+// every edge explored. If visit returns skip=true, that neighbor is not
+// enqueued for further exploration. It returns the nodes in the order they
+// were first reached.
+func (g *baseGraph) BFS(from string, visit func(node, neighbor string, cost uint) (skip bool)) ([]string, error) {
+	if !g.hasNode(from) {
+		return nil, ErrNodeNotFound
+	}
+
+	visited := map[string]bool{from: true}
+	order := []string{from}
+	queue := []string{from}
+
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+
+		for _, e := range g.neighbors(node) {
+			skip := visit(node, e.Neighbor, e.Cost)
+
+			if visited[e.Neighbor] || skip {
+				continue
+			}
+
+			visited[e.Neighbor] = true
+			order = append(order, e.Neighbor)
+			queue = append(queue, e.Neighbor)
+		}
+	}
+
+	return order, nil
+}
+
+// DFS walks every node of the graph depth-first, in node insertion order for
+// disconnected components, returning each node with its depth in the DFS
+// tree it was discovered in.
+func (g *baseGraph) DFS() ([]NodeAndDepth, error) {
+	visited := make(map[string]bool, len(g.nodes))
+	result := make([]NodeAndDepth, 0, len(g.nodes))
+
+	var walk func(node string, depth int)
+	walk = func(node string, depth int) {
+		visited[node] = true
+		result = append(result, NodeAndDepth{Node: node, Depth: depth})
+
+		for _, e := range g.neighbors(node) {
+			if visited[e.Neighbor] {
+				continue
+			}
+
+			walk(e.Neighbor, depth+1)
+		}
+	}
+
+	for _, node := range g.nodes {
+		if !visited[node] {
+			walk(node, 0)
+		}
+	}
+
+	return result, nil
+}
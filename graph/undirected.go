@@ -0,0 +1,28 @@
+package graph
+
+// UndirectedGraph is a weighted graph where every added edge is traversable
+// in both directions.
+type UndirectedGraph struct {
+	*baseGraph
+}
+
+// NewUndirectedGraph creates an empty UndirectedGraph.
+func NewUndirectedGraph() *UndirectedGraph {
+	return &UndirectedGraph{baseGraph: newBaseGraph()}
+}
+
+// AddWithCost adds a single directed edge Node->Neighbor. Most callers want
+// AddWithCostBoth; this is exposed for callers that build the reverse edge
+// themselves.
+func (g *UndirectedGraph) AddWithCost(e Edge) error {
+	g.addEdge(e)
+	return nil
+}
+
+// AddWithCostBoth adds e and its reverse, so that Node and Neighbor are each
+// other's neighbor with the same cost.
+func (g *UndirectedGraph) AddWithCostBoth(e Edge) error {
+	g.addEdge(e)
+	g.addEdge(Edge{Node: e.Neighbor, Neighbor: e.Node, Cost: e.Cost})
+	return nil
+}
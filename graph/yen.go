@@ -0,0 +1,242 @@
+package graph
+
+import "container/heap"
+
+// yenCandidate is a simple path and its total cost, tracked while Yen's
+// algorithm searches for the next cheapest loopless path.
+type yenCandidate struct {
+	path []string
+	cost uint
+}
+
+// yenCandidateQueue is a min-heap of yenCandidate ordered by cost.
+type yenCandidateQueue []yenCandidate
+
+func (pq yenCandidateQueue) Len() int            { return len(pq) }
+func (pq yenCandidateQueue) Less(i, j int) bool   { return pq[i].cost < pq[j].cost }
+func (pq yenCandidateQueue) Swap(i, j int)        { pq[i], pq[j] = pq[j], pq[i] }
+func (pq *yenCandidateQueue) Push(x interface{}) { *pq = append(*pq, x.(yenCandidate)) }
+func (pq *yenCandidateQueue) Pop() interface{} {
+	old := *pq
+	n := len(old)
+	item := old[n-1]
+	*pq = old[:n-1]
+	return item
+}
+
+type excludedEdge struct {
+	node, neighbor string
+}
+
+// dijkstraRestricted runs Dijkstra from "from" to "to" while treating nodes
+// in excludedNodes and edges in excludedEdges as absent from the graph. It
+// never mutates the graph itself.
+func (g *baseGraph) dijkstraRestricted(from, to string, excludedNodes map[string]bool, excludedEdges map[excludedEdge]bool) ([]string, uint, error) {
+	if excludedNodes[from] {
+		return nil, 0, ErrNoPath
+	}
+
+	cost := map[string]uint{from: 0}
+	prev := make(map[string]string)
+	visited := make(map[string]bool)
+
+	pq := &priorityQueue{{node: from, cost: 0}}
+	heap.Init(pq)
+
+	for pq.Len() > 0 {
+		item := heap.Pop(pq).(pqItem)
+
+		if visited[item.node] {
+			continue
+		}
+		visited[item.node] = true
+
+		if item.node == to {
+			break
+		}
+
+		for _, e := range g.neighbors(item.node) {
+			if excludedNodes[e.Neighbor] || excludedEdges[excludedEdge{item.node, e.Neighbor}] {
+				continue
+			}
+
+			next := item.cost + e.Cost
+
+			if existing, ok := cost[e.Neighbor]; !ok || next < existing {
+				cost[e.Neighbor] = next
+				prev[e.Neighbor] = item.node
+				heap.Push(pq, pqItem{node: e.Neighbor, cost: next})
+			}
+		}
+	}
+
+	total, ok := cost[to]
+	if !ok {
+		return nil, 0, ErrNoPath
+	}
+
+	path := []string{to}
+	for node := to; node != from; {
+		parent, ok := prev[node]
+		if !ok {
+			return nil, 0, ErrNoPath
+		}
+
+		path = append(path, parent)
+		node = parent
+	}
+
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+
+	return path, total, nil
+}
+
+// KShortestPaths returns up to k simple (loopless) paths from "from" to
+// "to", sorted by ascending total cost, using Yen's algorithm.
+func (g *baseGraph) KShortestPaths(from, to string, k int) ([][]string, []uint, error) {
+	return g.KShortestPathsWithDepth(from, to, k, 0)
+}
+
+// KShortestPathsWithDepth is KShortestPaths with an optional bound on the
+// number of edges a candidate path may contain. A maxDepth of 0 means
+// unbounded.
+func (g *baseGraph) KShortestPathsWithDepth(from, to string, k, maxDepth int) ([][]string, []uint, error) {
+	if k <= 0 {
+		return nil, nil, nil
+	}
+
+	shortest, cost, err := g.ShortestPathAndCost(from, to)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a := []yenCandidate{{path: shortest, cost: cost}}
+	seen := map[string]bool{pathKey(shortest): true}
+
+	b := &yenCandidateQueue{}
+	heap.Init(b)
+	bSeen := make(map[string]bool)
+
+	for i := 1; i < k; i++ {
+		prevPath := a[i-1].path
+
+		for j := 0; j < len(prevPath)-1; j++ {
+			spurNode := prevPath[j]
+			rootPath := prevPath[:j+1]
+			rootCost := pathCost(g, rootPath)
+
+			excludedEdges := make(map[excludedEdge]bool)
+			for _, candidate := range a {
+				if len(candidate.path) > j && equalPrefix(candidate.path, rootPath, j+1) {
+					excludedEdges[excludedEdge{candidate.path[j], candidate.path[j+1]}] = true
+				}
+			}
+
+			excludedNodes := make(map[string]bool)
+			for _, node := range rootPath[:j] {
+				excludedNodes[node] = true
+			}
+
+			spurPath, spurCost, err := g.dijkstraRestricted(spurNode, to, excludedNodes, excludedEdges)
+			if err != nil {
+				continue
+			}
+
+			if hasRepeatedVertex(rootPath[:j], spurPath) {
+				continue
+			}
+
+			total := append(append([]string{}, rootPath[:j]...), spurPath...)
+
+			if maxDepth > 0 && len(total)-1 > maxDepth {
+				continue
+			}
+
+			key := pathKey(total)
+			if seen[key] || bSeen[key] {
+				continue
+			}
+
+			bSeen[key] = true
+			heap.Push(b, yenCandidate{path: total, cost: rootCost + spurCost})
+		}
+
+		if b.Len() == 0 {
+			break
+		}
+
+		next := heap.Pop(b).(yenCandidate)
+		delete(bSeen, pathKey(next.path))
+		a = append(a, next)
+		seen[pathKey(next.path)] = true
+	}
+
+	paths := make([][]string, len(a))
+	costs := make([]uint, len(a))
+
+	for i, candidate := range a {
+		paths[i] = candidate.path
+		costs[i] = candidate.cost
+	}
+
+	return paths, costs, nil
+}
+
+func pathCost(g *baseGraph, path []string) uint {
+	var total uint
+
+	for i := 0; i+1 < len(path); i++ {
+		for _, e := range g.neighbors(path[i]) {
+			if e.Neighbor == path[i+1] {
+				total += e.Cost
+				break
+			}
+		}
+	}
+
+	return total
+}
+
+func pathKey(path []string) string {
+	var key string
+
+	for _, node := range path {
+		key += node + ">"
+	}
+
+	return key
+}
+
+func equalPrefix(a, b []string, n int) bool {
+	if len(a) < n || len(b) < n {
+		return false
+	}
+
+	for i := 0; i < n; i++ {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func hasRepeatedVertex(root, spur []string) bool {
+	seen := make(map[string]bool, len(root)+len(spur))
+
+	for _, node := range root {
+		seen[node] = true
+	}
+
+	for _, node := range spur {
+		if seen[node] {
+			return true
+		}
+
+		seen[node] = true
+	}
+
+	return false
+}
@@ -0,0 +1,64 @@
+package graph_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/karthick18/go-graph/graph"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKShortestPaths(t *testing.T) {
+	g := graph.NewUndirectedGraph()
+
+	g.AddWithCostBoth(graph.Edge{Node: "c", Neighbor: "d", Cost: uint(3)})
+	g.AddWithCostBoth(graph.Edge{Node: "c", Neighbor: "e", Cost: uint(2)})
+	g.AddWithCostBoth(graph.Edge{Node: "d", Neighbor: "e", Cost: uint(1)})
+	g.AddWithCostBoth(graph.Edge{Node: "d", Neighbor: "f", Cost: uint(4)})
+	g.AddWithCostBoth(graph.Edge{Node: "e", Neighbor: "f", Cost: uint(2)})
+	g.AddWithCostBoth(graph.Edge{Node: "f", Neighbor: "g", Cost: uint(1)})
+	g.AddWithCostBoth(graph.Edge{Node: "e", Neighbor: "g", Cost: uint(3)})
+
+	paths, costs, err := g.KShortestPaths("c", "g", 3)
+	assert.Nil(t, err, "error finding k shortest paths")
+	assert.Equal(t, 3, len(paths), "expected 3 candidate paths")
+	assert.Equal(t, len(paths), len(costs), "paths and costs should be the same length")
+
+	for i, path := range paths {
+		t.Log("candidate", i, strings.Join(path, "->"), "cost", costs[i])
+
+		if i > 0 {
+			assert.Equal(t, true, costs[i-1] <= costs[i], "candidate paths must be sorted by ascending cost")
+		}
+	}
+
+	assert.Equal(t, "c->e->g", strings.Join(paths[0], "->"), "cheapest path mismatch")
+	assert.Equal(t, uint(5), costs[0], "cheapest path cost mismatch")
+}
+
+func TestKShortestPathsNoPath(t *testing.T) {
+	g := graph.NewUndirectedGraph()
+	g.AddWithCostBoth(graph.Edge{Node: "a", Neighbor: "b", Cost: uint(1)})
+	g.AddNode("isolated")
+
+	_, _, err := g.KShortestPaths("a", "isolated", 3)
+	assert.ErrorIs(t, err, graph.ErrNoPath, "disconnected target should report ErrNoPath")
+}
+
+func TestKShortestPathsWithDepth(t *testing.T) {
+	g := graph.NewUndirectedGraph()
+
+	g.AddWithCostBoth(graph.Edge{Node: "a", Neighbor: "d", Cost: uint(2)})
+	g.AddWithCostBoth(graph.Edge{Node: "a", Neighbor: "b", Cost: uint(1)})
+	g.AddWithCostBoth(graph.Edge{Node: "b", Neighbor: "c", Cost: uint(1)})
+	g.AddWithCostBoth(graph.Edge{Node: "c", Neighbor: "d", Cost: uint(1)})
+
+	// The costlier multi-hop a->b->c->d candidate has 3 edges, over the depth
+	// bound of 1, so only the direct a->d edge should come back even though
+	// k asks for up to 3 paths.
+	paths, costs, err := g.KShortestPathsWithDepth("a", "d", 3, 1)
+	assert.Nil(t, err, "error finding depth-bounded k shortest paths")
+	assert.Equal(t, 1, len(paths), "only the direct edge should satisfy the depth bound")
+	assert.Equal(t, "a->d", strings.Join(paths[0], "->"), "depth-bounded path mismatch")
+	assert.Equal(t, uint(2), costs[0], "depth-bounded path cost mismatch")
+}